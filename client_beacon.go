@@ -1,12 +1,18 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math/bits"
+	"math/rand"
 	"net/http"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -17,8 +23,29 @@ import (
 var (
 	// Info Retrieval Global Timeout
 	InfoRetrievalTimeout = 61 * time.Second
+
+	// DefaultMaxRetries bounds the number of retries sendRequest makes for
+	// a single call, after the first attempt, before giving up on 5xx
+	// responses and network errors.
+	DefaultMaxRetries = 5
+
+	// DefaultInitialBackoff is the base delay before the first retry;
+	// later retries back off exponentially (with jitter) from here, unless
+	// a 429/503 response carries its own Retry-After.
+	DefaultInitialBackoff = 250 * time.Millisecond
+
+	// HeadStaleAfter bounds how long currentSlot trusts the event-stream
+	// head slot before falling back to GetOngoingSlotNumber. Without this,
+	// a dropped /eth/v1/events connection (consumeEvents doesn't reconnect)
+	// would freeze headSlot forever and wedge waitForSlot.
+	HeadStaleAfter = 30 * time.Second
 )
 
+// ErrSlotNotFound is returned for a 404 from the headers endpoint, which
+// happens routinely for skipped slots. Callers can use errors.Is to
+// distinguish an empty slot from a real request failure.
+var ErrSlotNotFound = errors.New("slot not found")
+
 type BeaconClient struct {
 	BaseURL    string
 	HTTPClient *http.Client
@@ -36,12 +63,33 @@ type BeaconClient struct {
 	// Merge Related
 	TTDTimestamp *uint64
 
-	// Lock
-	l sync.Mutex
+	// Retry/backoff tuning for sendRequest; zero values fall back to
+	// DefaultMaxRetries/DefaultInitialBackoff.
+	MaxRetries     int
+	InitialBackoff time.Duration
+
+	// Event stream, populated by Subscribe/consumeEvents. eventsOnce ensures
+	// GetDataPoint only starts the subscription once per client.
+	eventsOnce          sync.Once
+	events              *EventStream
+	attestationCountsMu sync.Mutex
+	attestationCounts   map[uint64]uint64
+	reorgDepths         map[uint64]uint64
+
+	// headMu guards headSlot/headSlotCh, the event-stream-derived view of
+	// the current head slot used to drive GetDataPoint's wait loop.
+	// headSlotCh is closed (and replaced) each time headSlot advances, so
+	// waiters can select on it instead of polling wall-clock time.
+	headMu     sync.Mutex
+	headSlot   uint64
+	headSlotAt time.Time
+	headSlotCh chan struct{}
 
-	// Context related
-	lastCtx    context.Context
-	lastCancel context.CancelFunc
+	// blocksMu guards the lazy initialization of blocks below.
+	blocksMu sync.Mutex
+	// Rolling window of recently-seen canonical blocks, used to detect and
+	// correct for reorgs/orphans.
+	blocks *BlockTracker
 }
 
 func (cl *BeaconClient) ClientType() ClientType {
@@ -53,10 +101,10 @@ func (cl *BeaconClient) UpdateTTDTimestamp(newTimestamp uint64) {
 	cl.TTDTimestamp = &timestamp
 }
 
-func (cl *BeaconClient) GetGenesisTime() *uint64 {
+func (cl *BeaconClient) GetGenesisTime(ctx context.Context) *uint64 {
 	if cl.GenesisTime == nil {
 		res := GenesisResponse{}
-		if err := cl.sendRequest(GET_REQUEST, V1_BEACON_GENESIS_ENDPOINT, &res); err == nil {
+		if err := cl.sendRequest(ctx, GET_REQUEST, V1_BEACON_GENESIS_ENDPOINT, nil, &res); err == nil {
 			genesisTime := res.GenesisTime
 			cl.GenesisTime = &genesisTime
 		}
@@ -64,8 +112,8 @@ func (cl *BeaconClient) GetGenesisTime() *uint64 {
 	return cl.GenesisTime
 }
 
-func (cl *BeaconClient) SlotAtTime(t uint64) (uint64, error) {
-	genesisTimeP := cl.GetGenesisTime()
+func (cl *BeaconClient) SlotAtTime(ctx context.Context, t uint64) (uint64, error) {
+	genesisTimeP := cl.GetGenesisTime(ctx)
 	if genesisTimeP == nil {
 		return 0, fmt.Errorf("No genesis yet")
 	}
@@ -75,21 +123,21 @@ func (cl *BeaconClient) SlotAtTime(t uint64) (uint64, error) {
 	}
 	return (t - genesisTime) / cl.Spec.SecondsPerSlot, nil
 }
-func (cl *BeaconClient) GetOngoingSlotNumber() (uint64, error) {
-	return cl.SlotAtTime(uint64(time.Now().Unix()))
+func (cl *BeaconClient) GetOngoingSlotNumber(ctx context.Context) (uint64, error) {
+	return cl.SlotAtTime(ctx, uint64(time.Now().Unix()))
 }
 
-func (cl *BeaconClient) GetLatestBlockSlotNumber() (uint64, error) {
-	return cl.GetOngoingSlotNumber()
+func (cl *BeaconClient) GetLatestBlockSlotNumber(ctx context.Context) (uint64, error) {
+	return cl.GetOngoingSlotNumber(ctx)
 }
 
-func (cl *BeaconClient) UpdateGetTTDBlockSlot() (*uint64, error) {
+func (cl *BeaconClient) UpdateGetTTDBlockSlot(ctx context.Context) (*uint64, error) {
 	// We need to have the TTD block timestamp from the Execution Clients
 	if cl.TTDSlotNumber != nil {
 		return cl.TTDSlotNumber, nil
 	}
 	if cl.TTDTimestamp != nil {
-		slotAtTTD, err := cl.SlotAtTime(*cl.TTDTimestamp)
+		slotAtTTD, err := cl.SlotAtTime(ctx, *cl.TTDTimestamp)
 		if err != nil {
 			fmt.Printf("Error getting slot at time: %v\n", err)
 			return nil, err
@@ -101,24 +149,280 @@ func (cl *BeaconClient) UpdateGetTTDBlockSlot() (*uint64, error) {
 	return nil, nil
 }
 
-func (cl *BeaconClient) GetBeaconBlock(slotNumber uint64) (*BeaconBlockResponse, error) {
+func (cl *BeaconClient) GetBeaconBlock(ctx context.Context, slotNumber uint64) (*BeaconBlockResponse, error) {
 	resp := BeaconBlockResponse{}
-	err := cl.sendRequest(GET_REQUEST, fmt.Sprintf(V1_BEACON_HEADERS_ENDPOINT, slotNumber), &resp)
+	err := cl.sendRequest(ctx, GET_REQUEST, fmt.Sprintf(V1_BEACON_HEADERS_ENDPOINT, slotNumber), nil, &resp)
 	return &resp, err
 }
 
-func (cl *BeaconClient) GetFinalityCheckpoints(slotNumber uint64) (*StateFinalityCheckpoints, error) {
+func (cl *BeaconClient) GetFinalityCheckpoints(ctx context.Context, slotNumber uint64) (*StateFinalityCheckpoints, error) {
 	resp := StateFinalityCheckpoints{}
-	if err := cl.sendRequest(GET_REQUEST, fmt.Sprintf(V1_BEACON_STATE_FINALITY_CHECKPOINTS_ENDPOINT, slotNumber), &resp); err != nil {
+	if err := cl.sendRequest(ctx, GET_REQUEST, fmt.Sprintf(V1_BEACON_STATE_FINALITY_CHECKPOINTS_ENDPOINT, slotNumber), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+const (
+	V1_BEACON_REWARDS_BLOCKS_ENDPOINT         = "/eth/v1/beacon/rewards/blocks/%d"
+	V1_BEACON_REWARDS_SYNC_COMMITTEE_ENDPOINT = "/eth/v1/beacon/rewards/sync_committee/%d"
+)
+
+const (
+	BlockProposerReward              MetricName = "BlockProposerReward"
+	BlockAttestationsReward          MetricName = "BlockAttestationsReward"
+	BlockSyncAggregateReward         MetricName = "BlockSyncAggregateReward"
+	BlockProposerSlashingsReward     MetricName = "BlockProposerSlashingsReward"
+	BlockAttesterSlashingsReward     MetricName = "BlockAttesterSlashingsReward"
+	SyncCommitteeParticipationReward MetricName = "SyncCommitteeParticipationReward"
+
+	ChainReorgDepth MetricName = "ChainReorgDepth"
+
+	OrphanedBlock MetricName = "OrphanedBlock"
+	OrphanDepth   MetricName = "OrphanDepth"
+
+	ExecutionBlobGasUsed   MetricName = "ExecutionBlobGasUsed"
+	ExecutionExcessBlobGas MetricName = "ExecutionExcessBlobGas"
+	BlobCommitmentCount    MetricName = "BlobCommitmentCount"
+)
+
+// isDenebActive reports whether slotNumber falls on or after DENEB_FORK_EPOCH,
+// so blob-gas metrics are only queried (and logged as errors on failure) once
+// the network has actually activated Deneb.
+func (cl *BeaconClient) isDenebActive(slotNumber uint64) bool {
+	if cl.Spec.SlotsPerEpoch == 0 {
+		return false
+	}
+	return slotNumber/cl.Spec.SlotsPerEpoch >= cl.Spec.DenebForkEpoch
+}
+
+// BlockRecord is a single tracked slot's chain-linkage, kept by BlockTracker
+// so that a later reorg can be detected and the slot's metrics corrected.
+type BlockRecord struct {
+	Slot             uint64
+	BlockRoot        common.Hash
+	ParentRoot       common.Hash
+	StateRoot        common.Hash
+	Orphaned         bool
+	OrphanDepth      uint64
+	SlotBlock        uint64
+	SlotAttestations uint64
+}
+
+// BlockTracker keeps a rolling window of recently-seen canonical blocks so a
+// later reorg can be detected and the slot's metrics corrected.
+type BlockTracker struct {
+	mu      sync.Mutex
+	window  uint64 // number of slots of history to retain, e.g. 2 epochs
+	records map[uint64]*BlockRecord
+}
+
+func newBlockTracker(window uint64) *BlockTracker {
+	return &BlockTracker{
+		window:  window,
+		records: make(map[uint64]*BlockRecord),
+	}
+}
+
+func (t *BlockTracker) get(slot uint64) *BlockRecord {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if rec, ok := t.records[slot]; ok {
+		copy := *rec
+		return &copy
+	}
+	return nil
+}
+
+// observe records the canonical block seen at slot. Reorg walk-back uses
+// blockRootAt/markOrphaned/setOrphanDepth below (see BeaconClient.observeBlock).
+func (t *BlockTracker) observe(slot uint64, blockRoot, parentRoot, stateRoot common.Hash) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	rec, ok := t.records[slot]
+	if !ok {
+		rec = &BlockRecord{Slot: slot}
+		t.records[slot] = rec
+	}
+	rec.BlockRoot = blockRoot
+	rec.ParentRoot = parentRoot
+	rec.StateRoot = stateRoot
+	rec.SlotBlock = 1
+	t.evictBefore(slot)
+}
+
+// blockRootAt returns the tracked block root at slot, if any.
+func (t *BlockTracker) blockRootAt(slot uint64) (common.Hash, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	rec, ok := t.records[slot]
+	if !ok || rec.BlockRoot == (common.Hash{}) {
+		return common.Hash{}, false
+	}
+	return rec.BlockRoot, true
+}
+
+// markOrphaned marks the record at slot as orphaned and zeroes its metrics,
+// reporting whether it did so (false if untracked or already orphaned).
+func (t *BlockTracker) markOrphaned(slot uint64) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	rec, ok := t.records[slot]
+	if !ok || rec.Orphaned {
+		return false
+	}
+	rec.Orphaned = true
+	rec.SlotBlock = 0
+	rec.SlotAttestations = 0
+	return true
+}
+
+// setOrphanDepth records how many prior slots observe's caller had to walk
+// back through and mark orphaned before the reorg at slot was resolved.
+func (t *BlockTracker) setOrphanDepth(slot, depth uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if rec, ok := t.records[slot]; ok {
+		rec.OrphanDepth = depth
+	}
+}
+
+// recordAttestations sets the attestation count for slot, creating the
+// record if observe hasn't been called for it yet.
+func (t *BlockTracker) recordAttestations(slot, count uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	rec, ok := t.records[slot]
+	if !ok {
+		rec = &BlockRecord{Slot: slot}
+		t.records[slot] = rec
+		t.evictBefore(slot)
+	}
+	rec.SlotAttestations = count
+}
+
+// history returns the tracked block records in the current window, in
+// ascending slot order, reflecting any retroactive orphan corrections.
+func (t *BlockTracker) history() []BlockRecord {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	out := make([]BlockRecord, 0, len(t.records))
+	for _, rec := range t.records {
+		out = append(out, *rec)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Slot < out[j].Slot })
+	return out
+}
+
+// evictBefore drops records older than the rolling window relative to slot.
+// Callers must hold t.mu.
+func (t *BlockTracker) evictBefore(slot uint64) {
+	if t.window == 0 || slot <= t.window {
+		return
+	}
+	cutoff := slot - t.window
+	for s := range t.records {
+		if s < cutoff {
+			delete(t.records, s)
+		}
+	}
+}
+
+// tracker lazily initializes the client's BlockTracker with a 2-epoch window.
+func (cl *BeaconClient) tracker() *BlockTracker {
+	cl.blocksMu.Lock()
+	defer cl.blocksMu.Unlock()
+	if cl.blocks == nil {
+		cl.blocks = newBlockTracker(cl.Spec.SlotsPerEpoch * 2)
+	}
+	return cl.blocks
+}
+
+// observeBlock records slot's canonical block and, if it no longer chains
+// into the block previously recorded at slot-1, walks back marking the
+// abandoned fork orphaned. Each step re-fetches the new chain's actual
+// ancestor via GetBeaconBlock rather than reusing the orphaned record's own
+// parent pointer (which only describes the stale fork and would stop the
+// walk after a single slot), so deep reorgs are corrected all the way back
+// to the common ancestor.
+func (cl *BeaconClient) observeBlock(ctx context.Context, slot uint64, block *BeaconBlockResponse) {
+	t := cl.tracker()
+	t.observe(slot, block.Root, block.ParentRoot, block.StateRoot)
+
+	expectedParent := block.ParentRoot
+	var depth uint64
+	for s := slot; s > 0; s-- {
+		root, ok := t.blockRootAt(s - 1)
+		if !ok || root == expectedParent {
+			break
+		}
+		if !t.markOrphaned(s - 1) {
+			break
+		}
+		depth++
+
+		ancestor, err := cl.GetBeaconBlock(ctx, s-1)
+		if err != nil {
+			break
+		}
+		expectedParent = ancestor.ParentRoot
+	}
+	if depth > 0 {
+		t.setOrphanDepth(slot, depth)
+	}
+}
+
+// CorrectedHistory returns this client's tracked block records, reflecting
+// any retroactive orphan corrections, so the output writer can re-emit rows
+// whose SlotBlock/SlotAttestations values changed after a reorg.
+func (cl *BeaconClient) CorrectedHistory() []BlockRecord {
+	return cl.tracker().history()
+}
+
+// BlockRewardsResponse models the response of `GET /eth/v1/beacon/rewards/blocks/{block_id}`.
+// All reward values are in Gwei, encoded as strings by the Beacon API.
+type BlockRewardsResponse struct {
+	ProposerIndex     string `json:"proposer_index"`
+	Total             string `json:"total"`
+	Attestations      string `json:"attestations"`
+	SyncAggregate     string `json:"sync_aggregate"`
+	ProposerSlashings string `json:"proposer_slashings"`
+	AttesterSlashings string `json:"attester_slashings"`
+}
+
+// SyncCommitteeReward is a single entry of the array returned by
+// `POST /eth/v1/beacon/rewards/sync_committee/{block_id}`.
+type SyncCommitteeReward struct {
+	ValidatorIndex string `json:"validator_index"`
+	Reward         string `json:"reward"`
+}
+
+func (cl *BeaconClient) GetBlockRewards(ctx context.Context, slotNumber uint64) (*BlockRewardsResponse, error) {
+	resp := BlockRewardsResponse{}
+	if err := cl.sendRequest(ctx, GET_REQUEST, fmt.Sprintf(V1_BEACON_REWARDS_BLOCKS_ENDPOINT, slotNumber), nil, &resp); err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
-func (cl *BeaconClient) GetSlotCommittees(slotNumber uint64) (*[]Committee, error) {
+// GetSyncCommitteeRewards fetches per-validator sync committee reward deltas for slotNumber.
+// A nil or empty validatorIndices requests rewards for all current committee members.
+func (cl *BeaconClient) GetSyncCommitteeRewards(ctx context.Context, slotNumber uint64, validatorIndices []string) (*[]SyncCommitteeReward, error) {
+	if validatorIndices == nil {
+		validatorIndices = []string{}
+	}
+	var resp []SyncCommitteeReward
+	if err := cl.sendRequest(ctx, POST_REQUEST, fmt.Sprintf(V1_BEACON_REWARDS_SYNC_COMMITTEE_ENDPOINT, slotNumber), validatorIndices, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (cl *BeaconClient) GetSlotCommittees(ctx context.Context, slotNumber uint64) (*[]Committee, error) {
 	committees := make([]Committee, 0)
 	var allCommittees []Committee
-	if err := cl.sendRequest(GET_REQUEST, fmt.Sprintf(V1_BEACON_STATE_COMMITTEES_ENDPOINT, slotNumber), &allCommittees); err != nil {
+	if err := cl.sendRequest(ctx, GET_REQUEST, fmt.Sprintf(V1_BEACON_STATE_COMMITTEES_ENDPOINT, slotNumber), nil, &allCommittees); err != nil {
 		return nil, err
 	}
 	for _, c := range allCommittees {
@@ -130,8 +434,8 @@ func (cl *BeaconClient) GetSlotCommittees(slotNumber uint64) (*[]Committee, erro
 	return &committees, nil
 }
 
-func (cl *BeaconClient) GetSlotCommitteeSize(slotNumber uint64) (uint64, error) {
-	slotCommittees, err := cl.GetSlotCommittees(slotNumber)
+func (cl *BeaconClient) GetSlotCommitteeSize(ctx context.Context, slotNumber uint64) (uint64, error) {
+	slotCommittees, err := cl.GetSlotCommittees(ctx, slotNumber)
 	if err != nil {
 		fmt.Printf("Error getting Slot Committees: %v\n", err)
 		return 0, err
@@ -143,21 +447,28 @@ func (cl *BeaconClient) GetSlotCommitteeSize(slotNumber uint64) (uint64, error)
 	return committeeCount, nil
 }
 
-func (cl *BeaconClient) GetAttestationsAtBlock(blockNumber uint64) (*[]Attestation, error) {
+func (cl *BeaconClient) GetAttestationsAtBlock(ctx context.Context, blockNumber uint64) (*[]Attestation, error) {
 	var allAttestations []Attestation
-	if err := cl.sendRequest(GET_REQUEST, fmt.Sprintf(V1_BEACON_BLOCKS_ATTESTATIONS_ENDPOINT, blockNumber), &allAttestations); err != nil {
+	if err := cl.sendRequest(ctx, GET_REQUEST, fmt.Sprintf(V1_BEACON_BLOCKS_ATTESTATIONS_ENDPOINT, blockNumber), nil, &allAttestations); err != nil {
 		return nil, err
 	}
 	return &allAttestations, nil
 }
 
-func (cl *BeaconClient) GetAttestationCountForSlot(slotNumber uint64) (uint64, error) {
+func (cl *BeaconClient) GetAttestationCountForSlot(ctx context.Context, slotNumber uint64) (uint64, error) {
+	if count, ok := cl.cachedAttestationCount(slotNumber); ok {
+		return count, nil
+	}
+
 	timeout := time.After(InfoRetrievalTimeout)
 	lastVerifiedBlock := slotNumber
 	for {
-		latestSlot, _ := cl.GetLatestBlockSlotNumber()
+		if count, ok := cl.cachedAttestationCount(slotNumber); ok {
+			return count, nil
+		}
+		latestSlot, _ := cl.GetLatestBlockSlotNumber(ctx)
 		for latestSlot > lastVerifiedBlock {
-			attBlock, err := cl.GetAttestationsAtBlock(lastVerifiedBlock + 1)
+			attBlock, err := cl.GetAttestationsAtBlock(ctx, lastVerifiedBlock+1)
 			if err != nil {
 				break
 			}
@@ -178,28 +489,390 @@ func (cl *BeaconClient) GetAttestationCountForSlot(slotNumber uint64) (uint64, e
 		case <-time.After(time.Second):
 		case <-timeout:
 			return 0, fmt.Errorf("Timeout waiting for attestation count")
+		case <-ctx.Done():
+			return 0, ctx.Err()
 		}
 
 	}
 }
 
-func (cl *BeaconClient) GetDataPoint(dataName MetricName, slotNumber uint64) (interface{}, error) {
+func (cl *BeaconClient) cachedAttestationCount(slotNumber uint64) (uint64, bool) {
+	cl.attestationCountsMu.Lock()
+	defer cl.attestationCountsMu.Unlock()
+	count, ok := cl.attestationCounts[slotNumber]
+	return count, ok
+}
+
+const V1_EVENTS_ENDPOINT = "/eth/v1/events"
+
+// HeadEvent is the payload of a `head` SSE event.
+type HeadEvent struct {
+	Slot                      string `json:"slot"`
+	Block                     string `json:"block"`
+	State                     string `json:"state"`
+	EpochTransition           bool   `json:"epoch_transition"`
+	PreviousDutyDependentRoot string `json:"previous_duty_dependent_root"`
+	CurrentDutyDependentRoot  string `json:"current_duty_dependent_root"`
+	ExecutionOptimistic       bool   `json:"execution_optimistic"`
+}
+
+// BlockEvent is the payload of a `block` SSE event.
+type BlockEvent struct {
+	Slot                string `json:"slot"`
+	Block               string `json:"block"`
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+}
+
+// AttestationEvent is the payload of an `attestation` SSE event; its shape
+// mirrors Attestation as returned by the blocks/attestations endpoint.
+type AttestationEvent Attestation
+
+// ChainReorgEvent is the payload of a `chain_reorg` SSE event.
+type ChainReorgEvent struct {
+	Slot                string `json:"slot"`
+	Depth               string `json:"depth"`
+	OldHeadBlock        string `json:"old_head_block"`
+	NewHeadBlock        string `json:"new_head_block"`
+	OldHeadState        string `json:"old_head_state"`
+	NewHeadState        string `json:"new_head_state"`
+	Epoch               string `json:"epoch"`
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+}
+
+// FinalizedCheckpointEvent is the payload of a `finalized_checkpoint` SSE event.
+type FinalizedCheckpointEvent struct {
+	Block               string `json:"block"`
+	State               string `json:"state"`
+	Epoch               string `json:"epoch"`
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+}
+
+// EventStream fans out the Beacon API `/eth/v1/events` SSE stream onto typed
+// channels, one per subscribed topic. Channels are closed once the
+// underlying connection ends.
+type EventStream struct {
+	Head                chan HeadEvent
+	Block               chan BlockEvent
+	Attestation         chan AttestationEvent
+	ChainReorg          chan ChainReorgEvent
+	FinalizedCheckpoint chan FinalizedCheckpointEvent
+	Err                 chan error
+}
+
+// Subscribe opens a long-lived connection to the Beacon API event stream and
+// returns an EventStream that will receive events for the requested topics
+// until ctx is cancelled.
+func (cl *BeaconClient) Subscribe(ctx context.Context, topics ...string) (*EventStream, error) {
+	endpoint := fmt.Sprintf("%s?topics=%s", V1_EVENTS_ENDPOINT, strings.Join(topics, ","))
+	req, err := http.NewRequestWithContext(ctx, GET_REQUEST, fmt.Sprintf("%s%s", cl.BaseURL, endpoint), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	res, err := cl.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("unexpected status code subscribing to events: %d", res.StatusCode)
+	}
+
+	stream := &EventStream{
+		Head:                make(chan HeadEvent),
+		Block:               make(chan BlockEvent),
+		Attestation:         make(chan AttestationEvent),
+		ChainReorg:          make(chan ChainReorgEvent),
+		FinalizedCheckpoint: make(chan FinalizedCheckpointEvent),
+		Err:                 make(chan error, 1),
+	}
+
+	go stream.readLoop(ctx, res.Body)
+
+	return stream, nil
+}
+
+// readLoop parses `event: <type>` / `data: <json>` SSE frames and dispatches
+// each decoded event onto its typed channel.
+func (stream *EventStream) readLoop(ctx context.Context, body io.ReadCloser) {
+	defer body.Close()
+	defer close(stream.Head)
+	defer close(stream.Block)
+	defer close(stream.Attestation)
+	defer close(stream.ChainReorg)
+	defer close(stream.FinalizedCheckpoint)
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var eventType string
+	for scanner.Scan() {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "event:"):
+			eventType = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			stream.dispatch(ctx, eventType, []byte(strings.TrimSpace(strings.TrimPrefix(line, "data:"))))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		select {
+		case stream.Err <- err:
+		default:
+		}
+	}
+}
+
+// dispatch decodes data as eventType's payload and sends it on the matching
+// typed channel, guarded by ctx.Done so a cancelled subscriber can't block it.
+func (stream *EventStream) dispatch(ctx context.Context, eventType string, data []byte) {
+	switch eventType {
+	case "head":
+		var ev HeadEvent
+		if err := json.Unmarshal(data, &ev); err == nil {
+			select {
+			case stream.Head <- ev:
+			case <-ctx.Done():
+			}
+		}
+	case "block":
+		var ev BlockEvent
+		if err := json.Unmarshal(data, &ev); err == nil {
+			select {
+			case stream.Block <- ev:
+			case <-ctx.Done():
+			}
+		}
+	case "attestation":
+		var ev AttestationEvent
+		if err := json.Unmarshal(data, &ev); err == nil {
+			select {
+			case stream.Attestation <- ev:
+			case <-ctx.Done():
+			}
+		}
+	case "chain_reorg":
+		var ev ChainReorgEvent
+		if err := json.Unmarshal(data, &ev); err == nil {
+			select {
+			case stream.ChainReorg <- ev:
+			case <-ctx.Done():
+			}
+		}
+	case "finalized_checkpoint":
+		var ev FinalizedCheckpointEvent
+		if err := json.Unmarshal(data, &ev); err == nil {
+			select {
+			case stream.FinalizedCheckpoint <- ev:
+			case <-ctx.Done():
+			}
+		}
+	}
+}
+
+// StartEventStream subscribes to the topics used to drive slot advancement
+// and starts consuming them in the background. It runs for the lifetime of
+// ctx; the only caller, ensureEventStream, passes context.Background().
+func (cl *BeaconClient) StartEventStream(ctx context.Context) error {
+	stream, err := cl.Subscribe(ctx, "head", "block", "attestation", "finalized_checkpoint", "chain_reorg")
+	if err != nil {
+		return err
+	}
+	cl.headMu.Lock()
+	cl.events = stream
+	cl.headMu.Unlock()
+	go cl.consumeEvents(ctx, stream)
+	return nil
+}
+
+// ensureEventStream lazily starts the SSE subscription backing waitForSlot,
+// once per client. A failure to subscribe (e.g. the beacon node doesn't
+// expose /eth/v1/events) is non-fatal: GetDataPoint just keeps polling.
+func (cl *BeaconClient) ensureEventStream() {
+	cl.eventsOnce.Do(func() {
+		if err := cl.StartEventStream(context.Background()); err != nil {
+			fmt.Printf("Error starting beacon event stream: %v\n", err)
+		}
+	})
+}
+
+func (cl *BeaconClient) consumeEvents(ctx context.Context, stream *EventStream) {
 	for {
-		// We fetch information only for previous slots, not current ongoing slot
-		ongoingSlot, _ := cl.GetOngoingSlotNumber()
-		if slotNumber >= ongoingSlot {
-			time.Sleep(time.Second)
-		} else {
-			break
+		select {
+		case <-ctx.Done():
+			return
+		case att, ok := <-stream.Attestation:
+			if !ok {
+				return
+			}
+			cl.recordAttestation(att)
+		case reorg, ok := <-stream.ChainReorg:
+			if !ok {
+				return
+			}
+			cl.handleChainReorg(reorg)
+		case err, ok := <-stream.Err:
+			if ok {
+				fmt.Printf("Error on beacon event stream: %v\n", err)
+			}
+		case head, ok := <-stream.Head:
+			if !ok {
+				return
+			}
+			cl.recordHeadSlot(head)
+		case <-stream.Block:
+		case <-stream.FinalizedCheckpoint:
+		}
+	}
+}
+
+// recordHeadSlot updates the cached head slot from a `head` SSE event and
+// wakes any GetDataPoint calls blocked in waitForSlot.
+func (cl *BeaconClient) recordHeadSlot(head HeadEvent) {
+	slot, err := strconv.ParseUint(head.Slot, 10, 64)
+	if err != nil {
+		return
+	}
+	cl.headMu.Lock()
+	defer cl.headMu.Unlock()
+	if slot <= cl.headSlot {
+		return
+	}
+	cl.headSlot = slot
+	cl.headSlotAt = time.Now()
+	if cl.headSlotCh != nil {
+		close(cl.headSlotCh)
+	}
+	cl.headSlotCh = make(chan struct{})
+}
+
+// currentSlot returns the event-stream-derived head slot if one has been
+// seen within HeadStaleAfter, falling back to wall-clock
+// GetOngoingSlotNumber otherwise (consumeEvents doesn't reconnect a dropped
+// SSE connection, so a stale headSlot can't be trusted indefinitely).
+func (cl *BeaconClient) currentSlot(ctx context.Context) uint64 {
+	cl.headMu.Lock()
+	slot := cl.headSlot
+	fresh := slot > 0 && time.Since(cl.headSlotAt) < HeadStaleAfter
+	cl.headMu.Unlock()
+	if fresh {
+		return slot
+	}
+	ongoingSlot, _ := cl.GetOngoingSlotNumber(ctx)
+	return ongoingSlot
+}
+
+// headAdvanced returns a channel that's closed the next time the
+// event-stream head slot advances, or nil if no event stream has started
+// yet (the caller should fall back to polling).
+func (cl *BeaconClient) headAdvanced() <-chan struct{} {
+	cl.headMu.Lock()
+	defer cl.headMu.Unlock()
+	if cl.events == nil {
+		return nil
+	}
+	return cl.headSlotCh
+}
+
+// waitForSlot blocks until slotNumber is no longer the ongoing slot, waking
+// on head-slot advancement when available and polling once a second as a
+// fallback.
+func (cl *BeaconClient) waitForSlot(ctx context.Context, slotNumber uint64) error {
+	for slotNumber >= cl.currentSlot(ctx) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-cl.headAdvanced():
+		case <-time.After(time.Second):
 		}
 	}
+	return nil
+}
+
+func (cl *BeaconClient) recordAttestation(att AttestationEvent) {
+	slot := att.Data.Slot
+	attCount := uint64(bits.OnesCount64(att.AggregationBits))
+	if attCount > 0 {
+		attCount -= 1
+	}
+
+	cl.attestationCountsMu.Lock()
+	defer cl.attestationCountsMu.Unlock()
+	if cl.attestationCounts == nil {
+		cl.attestationCounts = make(map[uint64]uint64)
+	}
+	if _, exists := cl.attestationCounts[slot]; !exists {
+		cl.attestationCounts[slot] = attCount
+	}
+}
+
+// handleChainReorg invalidates cached attestation counts back to the common
+// ancestor slot and records the reorg depth for reporting via ChainReorgDepth.
+func (cl *BeaconClient) handleChainReorg(reorg ChainReorgEvent) {
+	slot, err := strconv.ParseUint(reorg.Slot, 10, 64)
+	if err != nil {
+		return
+	}
+	depth, err := strconv.ParseUint(reorg.Depth, 10, 64)
+	if err != nil {
+		return
+	}
+
+	cl.attestationCountsMu.Lock()
+	defer cl.attestationCountsMu.Unlock()
+
+	if cl.reorgDepths == nil {
+		cl.reorgDepths = make(map[uint64]uint64)
+	}
+	cl.reorgDepths[slot] = depth
+
+	var commonAncestorSlot uint64
+	if depth < slot {
+		commonAncestorSlot = slot - depth
+	}
+	for s := range cl.attestationCounts {
+		if s > commonAncestorSlot {
+			delete(cl.attestationCounts, s)
+		}
+	}
+}
+
+func (cl *BeaconClient) GetDataPoint(ctx context.Context, dataName MetricName, slotNumber uint64) (interface{}, error) {
+	cl.ensureEventStream()
+	// We fetch information only for previous slots, not the current ongoing slot.
+	if err := cl.waitForSlot(ctx, slotNumber); err != nil {
+		return nil, err
+	}
 	switch dataName {
 	case SlotBlock:
-		_, err := cl.GetBeaconBlock(slotNumber)
-		if err == nil {
-			return uint64(1), nil
+		block, err := cl.GetBeaconBlock(ctx, slotNumber)
+		if err != nil {
+			if errors.Is(err, ErrSlotNotFound) {
+				return uint64(0), nil
+			}
+			return uint64(0), err
 		}
-		return uint64(0), nil
+		cl.observeBlock(ctx, slotNumber, block)
+		return uint64(1), nil
+	case OrphanedBlock:
+		rec := cl.tracker().get(slotNumber)
+		if rec == nil || !rec.Orphaned {
+			return uint64(0), nil
+		}
+		return uint64(1), nil
+	case OrphanDepth:
+		rec := cl.tracker().get(slotNumber)
+		if rec == nil {
+			return uint64(0), nil
+		}
+		return rec.OrphanDepth, nil
 	case FinalizedEpoch:
 		// Return `1` for each Finalized root change
 		if slotNumber == 0 {
@@ -209,7 +882,7 @@ func (cl *BeaconClient) GetDataPoint(dataName MetricName, slotNumber uint64) (in
 			return uint64(0), nil
 		}
 
-		currentSlotFinalityCheckpoint, err := cl.GetFinalityCheckpoints(slotNumber)
+		currentSlotFinalityCheckpoint, err := cl.GetFinalityCheckpoints(ctx, slotNumber)
 		if err != nil {
 			return nil, err
 		}
@@ -218,7 +891,7 @@ func (cl *BeaconClient) GetDataPoint(dataName MetricName, slotNumber uint64) (in
 			return uint64(0), nil
 		}
 
-		prevSlotFinalityCheckpoint, err := cl.GetFinalityCheckpoints(slotNumber - 1)
+		prevSlotFinalityCheckpoint, err := cl.GetFinalityCheckpoints(ctx, slotNumber-1)
 		if err != nil {
 			return nil, err
 		}
@@ -236,7 +909,7 @@ func (cl *BeaconClient) GetDataPoint(dataName MetricName, slotNumber uint64) (in
 			return uint64(0), nil
 		}
 
-		currentSlotFinalityCheckpoint, err := cl.GetFinalityCheckpoints(slotNumber)
+		currentSlotFinalityCheckpoint, err := cl.GetFinalityCheckpoints(ctx, slotNumber)
 		if err != nil {
 			return nil, err
 		}
@@ -245,7 +918,7 @@ func (cl *BeaconClient) GetDataPoint(dataName MetricName, slotNumber uint64) (in
 			return uint64(0), nil
 		}
 
-		prevSlotFinalityCheckpoint, err := cl.GetFinalityCheckpoints(slotNumber - 1)
+		prevSlotFinalityCheckpoint, err := cl.GetFinalityCheckpoints(ctx, slotNumber-1)
 		if err != nil {
 			return nil, err
 		}
@@ -255,36 +928,118 @@ func (cl *BeaconClient) GetDataPoint(dataName MetricName, slotNumber uint64) (in
 		return uint64(0), nil
 
 	case SlotAttestations:
-		slotAttestations, err := cl.GetAttestationCountForSlot(slotNumber)
+		slotAttestations, err := cl.GetAttestationCountForSlot(ctx, slotNumber)
 		if err != nil {
 			return slotAttestations, err
 		}
+		cl.tracker().recordAttestations(slotNumber, slotAttestations)
 		return slotAttestations, nil
 	case SlotAttestationsPercentage:
-		committeeSize, err := cl.GetSlotCommitteeSize(slotNumber)
+		committeeSize, err := cl.GetSlotCommitteeSize(ctx, slotNumber)
 		if err != nil {
 			return uint64(0), err
 		}
 		if committeeSize == 0 {
 			return committeeSize, fmt.Errorf("Empty committee for slot %d", slotNumber)
 		}
-		slotAttestations, err := cl.GetAttestationCountForSlot(slotNumber)
+		slotAttestations, err := cl.GetAttestationCountForSlot(ctx, slotNumber)
 		if err != nil {
 			return uint64(0), err
 		}
 		perc := (slotAttestations * 100) / committeeSize
 		return perc, nil
+	case BlockProposerReward:
+		rewards, err := cl.GetBlockRewards(ctx, slotNumber)
+		if err != nil {
+			return int64(0), err
+		}
+		return parseGweiString(rewards.Total)
+	case BlockAttestationsReward:
+		rewards, err := cl.GetBlockRewards(ctx, slotNumber)
+		if err != nil {
+			return int64(0), err
+		}
+		return parseGweiString(rewards.Attestations)
+	case BlockSyncAggregateReward:
+		rewards, err := cl.GetBlockRewards(ctx, slotNumber)
+		if err != nil {
+			return int64(0), err
+		}
+		return parseGweiString(rewards.SyncAggregate)
+	case BlockProposerSlashingsReward:
+		rewards, err := cl.GetBlockRewards(ctx, slotNumber)
+		if err != nil {
+			return int64(0), err
+		}
+		return parseGweiString(rewards.ProposerSlashings)
+	case BlockAttesterSlashingsReward:
+		rewards, err := cl.GetBlockRewards(ctx, slotNumber)
+		if err != nil {
+			return int64(0), err
+		}
+		return parseGweiString(rewards.AttesterSlashings)
+	case SyncCommitteeParticipationReward:
+		rewards, err := cl.GetSyncCommitteeRewards(ctx, slotNumber, nil)
+		if err != nil {
+			return int64(0), err
+		}
+		var total int64
+		for _, r := range *rewards {
+			reward, err := parseGweiString(r.Reward)
+			if err != nil {
+				return int64(0), err
+			}
+			total += reward
+		}
+		return total, nil
+	case ChainReorgDepth:
+		cl.attestationCountsMu.Lock()
+		depth, ok := cl.reorgDepths[slotNumber]
+		cl.attestationCountsMu.Unlock()
+		if !ok {
+			return uint64(0), nil
+		}
+		return depth, nil
+	case ExecutionBlobGasUsed:
+		if !cl.isDenebActive(slotNumber) {
+			return uint64(0), nil
+		}
+		block, err := cl.GetBeaconBlock(ctx, slotNumber)
+		if err != nil {
+			return uint64(0), err
+		}
+		return block.BlobGasUsed, nil
+	case ExecutionExcessBlobGas:
+		if !cl.isDenebActive(slotNumber) {
+			return uint64(0), nil
+		}
+		block, err := cl.GetBeaconBlock(ctx, slotNumber)
+		if err != nil {
+			return uint64(0), err
+		}
+		return block.ExcessBlobGas, nil
+	case BlobCommitmentCount:
+		if !cl.isDenebActive(slotNumber) {
+			return uint64(0), nil
+		}
+		block, err := cl.GetBeaconBlock(ctx, slotNumber)
+		if err != nil {
+			return uint64(0), err
+		}
+		return uint64(len(block.BlobKzgCommitments)), nil
 	}
 
 	return nil, fmt.Errorf("Invalid data name: %s", dataName)
 }
 
-func (cl *BeaconClient) Ctx() context.Context {
-	if cl.lastCtx != nil {
-		cl.lastCancel()
+// parseGweiString parses a Gwei-denominated reward value as returned by the
+// Beacon API, which encodes int64s as JSON strings.
+func parseGweiString(s string) (int64, error) {
+	var v int64
+	if _, err := fmt.Sscanf(s, "%d", &v); err != nil {
+		return 0, fmt.Errorf("invalid reward value %q: %v", s, err)
 	}
-	cl.lastCtx, cl.lastCancel = context.WithTimeout(context.Background(), 10*time.Second)
-	return cl.lastCtx
+	return v, nil
 }
 
 type errorResponse struct {
@@ -297,43 +1052,125 @@ type successResponse struct {
 	Data interface{} `json:"data"`
 }
 
-func (cl *BeaconClient) sendRequest(requestType string, requestEndPoint string, v interface{}) error {
-	cl.l.Lock()
-	defer cl.l.Unlock()
-	req, err := http.NewRequest(requestType, fmt.Sprintf("%s%s", cl.BaseURL, requestEndPoint), nil)
-	if err != nil {
-		return err
+// retryableStatus reports whether statusCode warrants a retry, along with
+// any server-requested delay read from the Retry-After header (0 if none
+// or unparseable).
+func retryableStatus(res *http.Response) (retryAfter time.Duration, retry bool) {
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		if ra := res.Header.Get("Retry-After"); ra != "" {
+			if secs, err := strconv.Atoi(ra); err == nil {
+				retryAfter = time.Duration(secs) * time.Second
+			}
+		}
+		return retryAfter, true
 	}
+	return 0, res.StatusCode >= http.StatusInternalServerError
+}
 
-	req = req.WithContext(cl.Ctx())
+// jitter returns a duration in [d/2, d], so that concurrent clients backing
+// off after the same failure don't retry in lockstep.
+func jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
 
-	req.Header.Set("Content-Type", "application/json; charset=utf-8")
-	req.Header.Set("Accept", "application/json; charset=utf-8")
+// sendRequest issues requestType against requestEndPoint and decodes the
+// `data` field of the response into v. body, when non-nil, is JSON-encoded
+// and sent as the request body. 5xx responses and network errors are
+// retried with exponential backoff and jitter, honoring Retry-After on
+// 429/503, up to MaxRetries/InitialBackoff (or their Default* fallbacks).
+func (cl *BeaconClient) sendRequest(ctx context.Context, requestType string, requestEndPoint string, body interface{}, v interface{}) error {
+	var bodyBytes []byte
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		bodyBytes = encoded
+	}
 
-	res, err := cl.HTTPClient.Do(req)
-	if err != nil {
-		return err
+	maxRetries := cl.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = DefaultMaxRetries
+	}
+	backoff := cl.InitialBackoff
+	if backoff == 0 {
+		backoff = DefaultInitialBackoff
 	}
 
-	defer res.Body.Close()
+	var lastErr error
+	var retryAfter time.Duration
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if attempt > 0 {
+			wait := jitter(backoff)
+			if retryAfter > 0 {
+				wait = retryAfter
+				retryAfter = 0
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+			backoff *= 2
+		}
 
-	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
-		var errRes errorResponse
-		if err = json.NewDecoder(res.Body).Decode(&errRes); err == nil {
-			return errors.New(errRes.Message)
+		var bodyReader io.Reader
+		if bodyBytes != nil {
+			bodyReader = bytes.NewReader(bodyBytes)
 		}
+		req, err := http.NewRequestWithContext(ctx, requestType, fmt.Sprintf("%s%s", cl.BaseURL, requestEndPoint), bodyReader)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json; charset=utf-8")
+		req.Header.Set("Accept", "application/json; charset=utf-8")
 
-		return fmt.Errorf("unknown error, status code: %d", res.StatusCode)
-	}
+		res, err := cl.HTTPClient.Do(req)
+		if err != nil {
+			lastErr = err
+			if attempt < maxRetries {
+				continue
+			}
+			return lastErr
+		}
 
-	fullResponse := successResponse{
-		Data: v,
-	}
-	if err = json.NewDecoder(res.Body).Decode(&fullResponse); err != nil {
+		if res.StatusCode == http.StatusNotFound && strings.Contains(requestEndPoint, "/beacon/headers/") {
+			res.Body.Close()
+			return ErrSlotNotFound
+		}
+
+		if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+			ra, retry := retryableStatus(res)
+			var errRes errorResponse
+			decodeErr := json.NewDecoder(res.Body).Decode(&errRes)
+			res.Body.Close()
+
+			if decodeErr == nil {
+				lastErr = errors.New(errRes.Message)
+			} else {
+				lastErr = fmt.Errorf("unknown error, status code: %d", res.StatusCode)
+			}
+
+			if retry && attempt < maxRetries {
+				retryAfter = ra
+				continue
+			}
+			return lastErr
+		}
+
+		fullResponse := successResponse{
+			Data: v,
+		}
+		err = json.NewDecoder(res.Body).Decode(&fullResponse)
+		res.Body.Close()
 		return err
 	}
 
-	return nil
+	return lastErr
 }
 
 type BeaconClients []*BeaconClient
@@ -362,8 +1199,11 @@ func (cls *BeaconClients) Set(baseUrl string) error {
 		HTTPClient: client,
 	}
 
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
 	res := Spec{}
-	if err := cl.sendRequest(GET_REQUEST, V1_CONFIG_SPEC_ENDPOINT, &res); err != nil {
+	if err := cl.sendRequest(ctx, GET_REQUEST, V1_CONFIG_SPEC_ENDPOINT, nil, &res); err != nil {
 		return err
 	}
 
@@ -372,3 +1212,196 @@ func (cls *BeaconClients) Set(baseUrl string) error {
 	*cls = append(*cls, &cl)
 	return nil
 }
+
+const (
+	HeadRootAgreement      MetricName = "HeadRootAgreement"
+	FinalizedRootAgreement MetricName = "FinalizedRootAgreement"
+	JustifiedRootAgreement MetricName = "JustifiedRootAgreement"
+)
+
+// Disagreement names the BaseURLs of clients in a BeaconClients pool whose
+// result for a metric/slot didn't match the consensus value.
+type Disagreement struct {
+	MetricName MetricName
+	SlotNumber uint64
+	Consensus  interface{}
+	Dissenting map[string]interface{}
+}
+
+// GetDataPoint fans dataName out to every client in the pool concurrently and
+// returns the consensus value (the most common result among clients that
+// answered without error) plus a Disagreement naming any outliers. A nil
+// Disagreement means every client that answered without error agreed;
+// clients that returned an error are left out of both.
+func (cls *BeaconClients) GetDataPoint(ctx context.Context, dataName MetricName, slotNumber uint64) (interface{}, *Disagreement, error) {
+	type clientResult struct {
+		baseURL string
+		value   interface{}
+		err     error
+	}
+
+	results := make([]clientResult, len(*cls))
+	var wg sync.WaitGroup
+	for i, cl := range *cls {
+		wg.Add(1)
+		go func(i int, cl *BeaconClient) {
+			defer wg.Done()
+			value, err := cl.GetDataPoint(ctx, dataName, slotNumber)
+			results[i] = clientResult{baseURL: cl.BaseURL, value: value, err: err}
+		}(i, cl)
+	}
+	wg.Wait()
+
+	values := make(map[string]interface{})
+	counts := make(map[interface{}]int)
+	var firstErr error
+	for _, r := range results {
+		if r.err != nil {
+			if firstErr == nil {
+				firstErr = r.err
+			}
+			continue
+		}
+		values[r.baseURL] = r.value
+		counts[r.value]++
+	}
+	if len(values) == 0 {
+		return nil, nil, firstErr
+	}
+
+	// Iterate baseURLs in sorted order so a tie between equally-common
+	// values always resolves to the same consensus value, regardless of
+	// the randomized order map iteration would otherwise produce.
+	sortedURLs := make([]string, 0, len(values))
+	for baseURL := range values {
+		sortedURLs = append(sortedURLs, baseURL)
+	}
+	sort.Strings(sortedURLs)
+
+	var consensus interface{}
+	bestCount := -1
+	for _, baseURL := range sortedURLs {
+		value := values[baseURL]
+		if count := counts[value]; count > bestCount {
+			consensus = value
+			bestCount = count
+		}
+	}
+
+	dissenting := make(map[string]interface{})
+	for baseURL, value := range values {
+		if value != consensus {
+			dissenting[baseURL] = value
+		}
+	}
+	if len(dissenting) == 0 {
+		return consensus, nil, nil
+	}
+	return consensus, &Disagreement{
+		MetricName: dataName,
+		SlotNumber: slotNumber,
+		Consensus:  consensus,
+		Dissenting: dissenting,
+	}, nil
+}
+
+// GetRootAgreement computes cross-client agreement for one of
+// HeadRootAgreement, FinalizedRootAgreement or JustifiedRootAgreement at
+// slotNumber, returning 1 if every client in the pool that answered without
+// error reports the same root and 0 otherwise; clients that returned an
+// error are not counted. These catch the merge-testnet failure mode that
+// single-client polling can't: clients forking from one another post-TTD.
+func (cls *BeaconClients) GetRootAgreement(ctx context.Context, dataName MetricName, slotNumber uint64) (uint64, *Disagreement, error) {
+	type clientResult struct {
+		baseURL string
+		root    common.Hash
+		err     error
+	}
+
+	results := make([]clientResult, len(*cls))
+	var wg sync.WaitGroup
+	for i, cl := range *cls {
+		wg.Add(1)
+		go func(i int, cl *BeaconClient) {
+			defer wg.Done()
+			root, err := cl.getRootFor(ctx, dataName, slotNumber)
+			results[i] = clientResult{baseURL: cl.BaseURL, root: root, err: err}
+		}(i, cl)
+	}
+	wg.Wait()
+
+	roots := make(map[string]interface{})
+	counts := make(map[common.Hash]int)
+	var firstErr error
+	for _, r := range results {
+		if r.err != nil {
+			if firstErr == nil {
+				firstErr = r.err
+			}
+			continue
+		}
+		roots[r.baseURL] = r.root
+		counts[r.root]++
+	}
+	if len(roots) == 0 {
+		return 0, nil, firstErr
+	}
+
+	// Same deterministic tie-break as GetDataPoint: resolve ties by the
+	// lexicographically-first BaseURL rather than map iteration order.
+	sortedURLs := make([]string, 0, len(roots))
+	for baseURL := range roots {
+		sortedURLs = append(sortedURLs, baseURL)
+	}
+	sort.Strings(sortedURLs)
+
+	var consensus common.Hash
+	bestCount := -1
+	for _, baseURL := range sortedURLs {
+		root := roots[baseURL].(common.Hash)
+		if count := counts[root]; count > bestCount {
+			consensus = root
+			bestCount = count
+		}
+	}
+
+	dissenting := make(map[string]interface{})
+	for baseURL, root := range roots {
+		if root != consensus {
+			dissenting[baseURL] = root
+		}
+	}
+	if len(dissenting) == 0 {
+		return 1, nil, nil
+	}
+	return 0, &Disagreement{
+		MetricName: dataName,
+		SlotNumber: slotNumber,
+		Consensus:  consensus,
+		Dissenting: dissenting,
+	}, nil
+}
+
+func (cl *BeaconClient) getRootFor(ctx context.Context, dataName MetricName, slotNumber uint64) (common.Hash, error) {
+	switch dataName {
+	case HeadRootAgreement:
+		block, err := cl.GetBeaconBlock(ctx, slotNumber)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		return block.Root, nil
+	case FinalizedRootAgreement:
+		fc, err := cl.GetFinalityCheckpoints(ctx, slotNumber)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		return fc.Finalized.Root, nil
+	case JustifiedRootAgreement:
+		fc, err := cl.GetFinalityCheckpoints(ctx, slotNumber)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		return fc.Justified.Root, nil
+	}
+	return common.Hash{}, fmt.Errorf("invalid root agreement metric: %s", dataName)
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrBlockNotFound is returned when eth_getBlockByNumber responds with a
+// null result, which happens for a block number past the current head.
+var ErrBlockNotFound = errors.New("block not found")
+
+type ExecutionClient struct {
+	BaseURL    string
+	HTTPClient *http.Client
+
+	// Retry/backoff tuning for sendRequest; zero values fall back to
+	// DefaultMaxRetries/DefaultInitialBackoff.
+	MaxRetries     int
+	InitialBackoff time.Duration
+}
+
+func (cl *ExecutionClient) ClientType() ClientType {
+	return Execution
+}
+
+const BlobTxCount MetricName = "BlobTxCount"
+
+// ExecutionBlockResponse models the subset of `eth_getBlockByNumber` we care
+// about, including the Deneb `blobGasUsed`/`excessBlobGas` header fields.
+type ExecutionBlockResponse struct {
+	Number        string                 `json:"number"`
+	Hash          string                 `json:"hash"`
+	BlobGasUsed   string                 `json:"blobGasUsed"`
+	ExcessBlobGas string                 `json:"excessBlobGas"`
+	Transactions  []ExecutionTransaction `json:"transactions"`
+}
+
+type ExecutionTransaction struct {
+	Hash string `json:"hash"`
+	Type string `json:"type"`
+}
+
+func (cl *ExecutionClient) GetBlockByNumber(ctx context.Context, blockNumber uint64) (*ExecutionBlockResponse, error) {
+	resp := ExecutionBlockResponse{}
+	param := fmt.Sprintf("0x%x", blockNumber)
+	if err := cl.sendRequest(ctx, "eth_getBlockByNumber", []interface{}{param, true}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (cl *ExecutionClient) GetDataPoint(ctx context.Context, dataName MetricName, blockNumber uint64) (interface{}, error) {
+	switch dataName {
+	case ExecutionBlobGasUsed:
+		block, err := cl.GetBlockByNumber(ctx, blockNumber)
+		if err != nil {
+			return uint64(0), err
+		}
+		return parseHexUint64(block.BlobGasUsed)
+	case ExecutionExcessBlobGas:
+		block, err := cl.GetBlockByNumber(ctx, blockNumber)
+		if err != nil {
+			return uint64(0), err
+		}
+		return parseHexUint64(block.ExcessBlobGas)
+	case BlobTxCount:
+		block, err := cl.GetBlockByNumber(ctx, blockNumber)
+		if err != nil {
+			return uint64(0), err
+		}
+		var count uint64
+		for _, tx := range block.Transactions {
+			if tx.Type == "0x3" {
+				count++
+			}
+		}
+		return count, nil
+	}
+
+	return nil, fmt.Errorf("Invalid data name: %s", dataName)
+}
+
+// parseHexUint64 parses a `0x`-prefixed hex integer as returned by
+// eth_getBlockByNumber.
+func parseHexUint64(s string) (uint64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	v, err := strconv.ParseUint(strings.TrimPrefix(s, "0x"), 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid hex value %q: %v", s, err)
+	}
+	return v, nil
+}
+
+type jsonRPCRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+	ID      int           `json:"id"`
+}
+
+type jsonRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type jsonRPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      int             `json:"id"`
+	Result  json.RawMessage `json:"result"`
+	Error   *jsonRPCError   `json:"error"`
+}
+
+// sendRequest issues a JSON-RPC call, retrying with exponential backoff (and
+// jitter) on network errors and 5xx responses, honoring Retry-After on
+// 429/503. It mirrors BeaconClient.sendRequest's retry pipeline so both
+// clients back off and cancel consistently under ctx.
+func (cl *ExecutionClient) sendRequest(ctx context.Context, method string, params []interface{}, v interface{}) error {
+	reqBody, err := json.Marshal(jsonRPCRequest{
+		JSONRPC: "2.0",
+		Method:  method,
+		Params:  params,
+		ID:      1,
+	})
+	if err != nil {
+		return err
+	}
+
+	maxRetries := cl.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = DefaultMaxRetries
+	}
+	backoff := cl.InitialBackoff
+	if backoff == 0 {
+		backoff = DefaultInitialBackoff
+	}
+
+	var lastErr error
+	var retryAfter time.Duration
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if attempt > 0 {
+			wait := jitter(backoff)
+			if retryAfter > 0 {
+				wait = retryAfter
+				retryAfter = 0
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+			backoff *= 2
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, cl.BaseURL, bytes.NewReader(reqBody))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+		res, err := cl.HTTPClient.Do(req)
+		if err != nil {
+			lastErr = err
+			if attempt < maxRetries {
+				continue
+			}
+			return lastErr
+		}
+
+		if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+			ra, retry := retryableStatus(res)
+			res.Body.Close()
+			lastErr = fmt.Errorf("unknown error, status code: %d", res.StatusCode)
+			if retry && attempt < maxRetries {
+				retryAfter = ra
+				continue
+			}
+			return lastErr
+		}
+
+		var rpcRes jsonRPCResponse
+		err = json.NewDecoder(res.Body).Decode(&rpcRes)
+		res.Body.Close()
+		if err != nil {
+			return err
+		}
+		if rpcRes.Error != nil {
+			return errors.New(rpcRes.Error.Message)
+		}
+		if len(rpcRes.Result) == 0 || bytes.Equal(rpcRes.Result, []byte("null")) {
+			return ErrBlockNotFound
+		}
+		return json.Unmarshal(rpcRes.Result, v)
+	}
+	return lastErr
+}